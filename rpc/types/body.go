@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// Body is the Ethereum-shaped block body that rides alongside an EthHeader:
+// the transaction list plus, post-Shanghai, the withdrawal list.
+type Body struct {
+	Transactions gethtypes.Transactions
+	Withdrawals  Withdrawals
+}
+
+// VerifyWithdrawalsHash checks that the header's WithdrawalsHash is consistent
+// with the given withdrawal list: EmptyRootHash for an empty/nil list, and
+// DeriveSha(withdrawals) otherwise. Headers from before Shanghai, which carry
+// a nil WithdrawalsHash, are left untouched so their hash stays stable.
+func (h *EthHeader) VerifyWithdrawalsHash(withdrawals Withdrawals) error {
+	if h.WithdrawalsHash == nil {
+		if len(withdrawals) != 0 {
+			return fmt.Errorf("header has no withdrawals root but %d withdrawals were provided", len(withdrawals))
+		}
+		return nil
+	}
+
+	want := gethtypes.EmptyRootHash
+	if len(withdrawals) > 0 {
+		want = gethtypes.DeriveSha(withdrawals, trie.NewStackTrie(nil))
+	}
+
+	if *h.WithdrawalsHash != want {
+		return fmt.Errorf("withdrawals root mismatch: header has %s, computed %s", h.WithdrawalsHash.Hex(), want.Hex())
+	}
+	return nil
+}
+
+// BackfillWithdrawalsHash clears WithdrawalsHash on headers from before the
+// Shanghai upgrade. It exists so that re-deriving headers for historical
+// blocks (e.g. when replaying or re-indexing) never introduces the field
+// where it never existed, which would change the header's RLP hash.
+func BackfillWithdrawalsHash(h *EthHeader, height, shanghaiHeight int64) {
+	if height < shanghaiHeight {
+		h.WithdrawalsHash = nil
+	}
+}
@@ -8,38 +8,57 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 //go:generate go run github.com/fjl/gencodec -type Header -field-override headerMarshaling -out gen_header_json.go
-//go:generate go run ../../rlp/rlpgen -type Header -out gen_header_rlp.go
 
 // Header represents a block header in the Ethereum blockchain.
+//
+// There is deliberately no generated gen_header_rlp.go / EncodeRLP override
+// here: a generated encoder would be frozen at the field set it was built
+// from and would silently go stale every time a field (and its rlp tag) is
+// added, as happened with CosmosHeaderHash and WithdrawalsHash. rlp.Encode's
+// reflection-based codec, which honors "-" and "optional" tags, is the
+// authoritative RLP encoding for this type; keep it that way rather than
+// regenerating.
 type EthHeader struct {
-	ParentHash  common.Hash          `json:"parentHash"       gencodec:"required"`
-	UncleHash   common.Hash          `json:"sha3Uncles"       gencodec:"required"`
-	Coinbase    common.Address       `json:"miner"`
-	Root        common.Hash          `json:"stateRoot"        gencodec:"required"`
-	TxHash      common.Hash          `json:"transactionsRoot" gencodec:"required"`
-	ReceiptHash common.Hash          `json:"receiptsRoot"     gencodec:"required"`
-	Bloom       gethtypes.Bloom      `json:"logsBloom"        gencodec:"required"`
-	Difficulty  *big.Int             `json:"difficulty"       gencodec:"required"`
-	Number      *big.Int             `json:"number"           gencodec:"required"`
-	GasLimit    uint64               `json:"gasLimit"         gencodec:"required"`
-	GasUsed     uint64               `json:"gasUsed"          gencodec:"required"`
-	Time        uint64               `json:"timestamp"        gencodec:"required"`
-	Extra       []byte               `json:"extraData"        gencodec:"required"`
-	MixDigest   common.Hash          `json:"mixHash"`
-	Nonce       gethtypes.BlockNonce `json:"nonce"`
+	ParentHash  common.Hash     `json:"parentHash"       gencodec:"required"`
+	UncleHash   common.Hash     `json:"sha3Uncles"       gencodec:"required"`
+	Coinbase    common.Address  `json:"miner"`
+	Root        common.Hash     `json:"stateRoot"        gencodec:"required"`
+	TxHash      common.Hash     `json:"transactionsRoot" gencodec:"required"`
+	ReceiptHash common.Hash     `json:"receiptsRoot"     gencodec:"required"`
+	Bloom       gethtypes.Bloom `json:"logsBloom"        gencodec:"required"`
+	Difficulty  *big.Int        `json:"difficulty"       gencodec:"required"`
+	Number      *big.Int        `json:"number"           gencodec:"required"`
+	GasLimit    uint64          `json:"gasLimit"         gencodec:"required"`
+	GasUsed     uint64          `json:"gasUsed"          gencodec:"required"`
+	Time        uint64          `json:"timestamp"        gencodec:"required"`
+	Extra       []byte          `json:"extraData"        gencodec:"required"`
+	// MixDigest is the legacy PoW mix hash pre-merge. Post-merge (see
+	// ChainConfig.MergeNetsplitBlock), the field is repurposed to carry the
+	// PREVRANDAO value instead, matching go-ethereum's own header semantics;
+	// Difficulty is forced to zero in that case. RPCMarshalHeader exposes the
+	// post-merge value under both "mixHash" and "prevRandao" for clients that
+	// have already migrated to the new key.
+	MixDigest common.Hash          `json:"mixHash"`
+	Nonce     gethtypes.BlockNonce `json:"nonce"`
 
 	// BaseFee was added by EIP-1559 and is ignored in legacy headers.
 	BaseFee *big.Int `json:"baseFeePerGas""`
 
-	/*
-		TODO (MariusVanDerWijden) Add this field once needed
-		// Random was added during the merge and contains the BeaconState randomness
-		Random common.Hash `json:"random" rlp:"optional"`
-	*/
-	CosmosHeaderHash common.Hash
+	// WithdrawalsHash was added by EIP-4895 and is the root of the withdrawals
+	// trie for the block. It is optional so that pre-Shanghai headers, which
+	// never had the field, continue to hash exactly as before.
+	WithdrawalsHash *common.Hash `json:"withdrawalsRoot" rlp:"optional"`
+
+	// CosmosHeaderHash is the CometBFT block hash, kept alongside the
+	// Ethereum-canonical hash computed by Hash(). It is excluded from the RLP
+	// encoding since it has no equivalent in go-ethereum's header and must
+	// not perturb the keccak256(RLP) hash external tools recompute.
+	CosmosHeaderHash common.Hash `rlp:"-"`
 }
 
 // field type overrides for gencodec
@@ -54,14 +73,33 @@ type headerMarshaling struct {
 	Hash       common.Hash `json:"hash"` // adds call to Hash() in MarshalJSON
 }
 
-// Hash returns the block hash of the header, which is simply the keccak256 hash of its
-// RLP encoding.
+// Hash returns the block hash of the header, which is simply the keccak256
+// hash of its RLP encoding. This matches go-ethereum semantics exactly, so
+// that graphql clients, ethclient, and block explorers that recompute the
+// hash from the RLP they fetched get the value they expect.
+//
+// Subscribers that need to round-trip through eth_getBlockByHash using the
+// CometBFT block hash instead (e.g. existing "newHeads" consumers) should use
+// CometHash. The keeper's SetHashMapping/GetCometHashByEthHash index maps
+// between the two hashes; no JSON-RPC backend in this tree consults it.
 func (h *EthHeader) Hash() common.Hash {
-	// replace with cometbft block hash in order to the user who subscribed
-	// the "newHeads" message by web socket can get block by "eth_getBlockByHash" successfully.
+	return rlpHash(h)
+}
+
+// CometHash returns the CometBFT block hash this header was derived from.
+func (h *EthHeader) CometHash() common.Hash {
 	return h.CosmosHeaderHash
 }
 
+// rlpHash encodes x and hashes the encoding with keccak256.
+func rlpHash(x interface{}) (h common.Hash) {
+	b, err := rlp.EncodeToBytes(x)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(b)
+}
+
 var headerSize = common.StorageSize(reflect.TypeOf(EthHeader{}).Size())
 
 // Size returns the approximate memory used by all internal contents. It is used
@@ -73,8 +111,10 @@ func (h *EthHeader) Size() common.StorageSize {
 // SanityCheck checks a few basic things -- these checks are way beyond what
 // any 'sane' production values should hold, and can mainly be used to prevent
 // that the unbounded fields are stuffed with junk data to add processing
-// overhead
-func (h *EthHeader) SanityCheck() error {
+// overhead. isMerge should be true for headers at or after the configured
+// merge block, in which case Difficulty must be zero and MixDigest instead
+// carries PREVRANDAO.
+func (h *EthHeader) SanityCheck(isMerge bool) error {
 	if h.Number != nil && !h.Number.IsUint64() {
 		return fmt.Errorf("too large block number: bitlen %d", h.Number.BitLen())
 	}
@@ -82,6 +122,9 @@ func (h *EthHeader) SanityCheck() error {
 		if diffLen := h.Difficulty.BitLen(); diffLen > 80 {
 			return fmt.Errorf("too large block difficulty: bitlen %d", diffLen)
 		}
+		if isMerge && h.Difficulty.Sign() != 0 {
+			return fmt.Errorf("non-zero difficulty %s for post-merge header", h.Difficulty)
+		}
 	}
 	if eLen := len(h.Extra); eLen > 100*1024 {
 		return fmt.Errorf("too large block extradata: size %d", eLen)
@@ -94,6 +137,21 @@ func (h *EthHeader) SanityCheck() error {
 	return nil
 }
 
+// ApplyMergeRules enforces the post-merge header invariants SanityCheck only
+// rejects violations of: when isMerge is true, Difficulty is forced to zero
+// and MixDigest is repurposed to carry prevRandao, matching go-ethereum's
+// own post-merge header semantics. It is a no-op pre-merge. Callers that
+// build or replay a header - EndBlocker for newly produced blocks, re-indexing
+// for historical ones - should call this before computing Hash(), the same
+// way BackfillWithdrawalsHash normalizes WithdrawalsHash before hashing.
+func (h *EthHeader) ApplyMergeRules(isMerge bool, prevRandao common.Hash) {
+	if !isMerge {
+		return
+	}
+	h.Difficulty = new(big.Int)
+	h.MixDigest = prevRandao
+}
+
 // EmptyBody returns true if there is no additional 'body' to complete the header
 // that is: no transactions and no uncles.
 func (h *EthHeader) EmptyBody() bool {
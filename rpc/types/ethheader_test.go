@@ -0,0 +1,146 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHashMatchesGeth asserts that EthHeader.Hash() is the keccak256 of the
+// header's RLP encoding exactly as go-ethereum computes it, for both a
+// pre-Shanghai header (WithdrawalsHash nil) and a post-Shanghai one. A
+// regression here would mean a generated or hand-written EncodeRLP is
+// shadowing the reflection-based codec again, or that CosmosHeaderHash has
+// leaked into the encoding.
+func TestHashMatchesGeth(t *testing.T) {
+	withdrawalsHash := common.HexToHash("0x1234")
+
+	testCases := []struct {
+		name            string
+		withdrawalsHash *common.Hash
+	}{
+		{"pre-Shanghai header", nil},
+		{"post-Shanghai header", &withdrawalsHash},
+	}
+
+	for _, tc := range testCases {
+		h := &EthHeader{
+			ParentHash:      common.HexToHash("0xaa"),
+			UncleHash:       gethtypes.EmptyUncleHash,
+			Coinbase:        common.HexToAddress("0xbb"),
+			Root:            common.HexToHash("0xcc"),
+			TxHash:          gethtypes.EmptyRootHash,
+			ReceiptHash:     gethtypes.EmptyRootHash,
+			Difficulty:      big.NewInt(0),
+			Number:          big.NewInt(1),
+			GasLimit:        30_000_000,
+			GasUsed:         21_000,
+			Time:            1_700_000_000,
+			Extra:           []byte{},
+			MixDigest:       common.HexToHash("0xdd"),
+			BaseFee:         big.NewInt(875_000_000),
+			WithdrawalsHash: tc.withdrawalsHash,
+			// CosmosHeaderHash must not perturb the RLP-derived hash.
+			CosmosHeaderHash: common.HexToHash("0xffff"),
+		}
+
+		gethHeader := &gethtypes.Header{
+			ParentHash:      h.ParentHash,
+			UncleHash:       h.UncleHash,
+			Coinbase:        h.Coinbase,
+			Root:            h.Root,
+			TxHash:          h.TxHash,
+			ReceiptHash:     h.ReceiptHash,
+			Difficulty:      h.Difficulty,
+			Number:          h.Number,
+			GasLimit:        h.GasLimit,
+			GasUsed:         h.GasUsed,
+			Time:            h.Time,
+			Extra:           h.Extra,
+			MixDigest:       h.MixDigest,
+			BaseFee:         h.BaseFee,
+			WithdrawalsHash: tc.withdrawalsHash,
+		}
+
+		require.Equal(t, gethHeader.Hash(), h.Hash(), tc.name)
+		require.NotEqual(t, h.CosmosHeaderHash, h.Hash(), tc.name)
+	}
+}
+
+// TestApplyMergeRules asserts that ApplyMergeRules only rewrites
+// Difficulty/MixDigest when isMerge is true, and that it zeroes Difficulty
+// and sets MixDigest to prevRandao rather than merely validating them.
+func TestApplyMergeRules(t *testing.T) {
+	prevRandao := common.HexToHash("0xd00d")
+
+	t.Run("pre-merge leaves difficulty and mix digest untouched", func(t *testing.T) {
+		h := &EthHeader{Difficulty: big.NewInt(123456), MixDigest: common.HexToHash("0xaa")}
+		h.ApplyMergeRules(false, prevRandao)
+		require.Equal(t, big.NewInt(123456), h.Difficulty)
+		require.Equal(t, common.HexToHash("0xaa"), h.MixDigest)
+	})
+
+	t.Run("post-merge zeroes difficulty and adopts prevRandao as the mix digest", func(t *testing.T) {
+		h := &EthHeader{Difficulty: big.NewInt(123456), MixDigest: common.HexToHash("0xaa")}
+		h.ApplyMergeRules(true, prevRandao)
+		require.Equal(t, big.NewInt(0), h.Difficulty)
+		require.Equal(t, prevRandao, h.MixDigest)
+	})
+}
+
+// TestSanityCheck asserts SanityCheck enforces the post-merge
+// zero-difficulty rule in addition to its size-based checks, and that a
+// pre-merge header with the same non-zero difficulty is accepted.
+func TestSanityCheck(t *testing.T) {
+	testCases := []struct {
+		name     string
+		header   *EthHeader
+		isMerge  bool
+		expError bool
+	}{
+		{
+			"pre-merge header with non-zero difficulty is valid",
+			&EthHeader{Number: big.NewInt(1), Difficulty: big.NewInt(100)},
+			false,
+			false,
+		},
+		{
+			"post-merge header with zero difficulty is valid",
+			&EthHeader{Number: big.NewInt(1), Difficulty: big.NewInt(0)},
+			true,
+			false,
+		},
+		{
+			"post-merge header with non-zero difficulty is rejected",
+			&EthHeader{Number: big.NewInt(1), Difficulty: big.NewInt(1)},
+			true,
+			true,
+		},
+		{
+			"block number too large to fit a uint64 is rejected",
+			&EthHeader{Number: new(big.Int).Lsh(big.NewInt(1), 64)},
+			false,
+			true,
+		},
+		{
+			"oversized extra data is rejected",
+			&EthHeader{Number: big.NewInt(1), Extra: make([]byte, 100*1024+1)},
+			false,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.header.SanityCheck(tc.isMerge)
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
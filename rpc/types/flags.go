@@ -0,0 +1,7 @@
+package types
+
+// FlagLegacyCosmosHash is the JSON-RPC server flag that restores the
+// pre-chunk0-3 behavior of reporting the CometBFT block hash wherever an
+// Ethereum block hash is expected, for operators whose integrations still
+// depend on it.
+const FlagLegacyCosmosHash = "json-rpc.legacy-cosmos-hash"
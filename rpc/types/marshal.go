@@ -0,0 +1,77 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RPCMarshalHeader converts the given header into a JSON-RPC compatible map,
+// the same shape go-ethereum returns from eth_getBlockByNumber/ByHash.
+//
+// Post-merge, MixDigest carries PREVRANDAO rather than a PoW mix hash; the
+// value is duplicated under "prevRandao" alongside the legacy "mixHash" key
+// so that clients built against either naming work unmodified.
+//
+// legacyCosmosHash controls which hash is reported under "hash": by default
+// this is the Ethereum-canonical Hash(), but operators who started the node
+// with --json-rpc.legacy-cosmos-hash get the previous behavior of CometHash()
+// so existing integrations that depend on it keep working unmodified.
+func RPCMarshalHeader(h *EthHeader, isMerge, legacyCosmosHash bool) map[string]interface{} {
+	blockHash := h.Hash()
+	if legacyCosmosHash {
+		blockHash = h.CometHash()
+	}
+
+	result := map[string]interface{}{
+		"parentHash":       h.ParentHash,
+		"sha3Uncles":       h.UncleHash,
+		"miner":            h.Coinbase,
+		"stateRoot":        h.Root,
+		"transactionsRoot": h.TxHash,
+		"receiptsRoot":     h.ReceiptHash,
+		"logsBloom":        h.Bloom,
+		"difficulty":       (*hexutil.Big)(h.Difficulty),
+		"number":           (*hexutil.Big)(h.Number),
+		"gasLimit":         hexutil.Uint64(h.GasLimit),
+		"gasUsed":          hexutil.Uint64(h.GasUsed),
+		"timestamp":        hexutil.Uint64(h.Time),
+		"extraData":        hexutil.Bytes(h.Extra),
+		"mixHash":          h.MixDigest,
+		"nonce":            h.Nonce,
+		"hash":             blockHash,
+	}
+	if h.BaseFee != nil {
+		result["baseFeePerGas"] = (*hexutil.Big)(h.BaseFee)
+	}
+	if isMerge {
+		result["prevRandao"] = h.MixDigest
+	}
+	if h.WithdrawalsHash != nil {
+		result["withdrawalsRoot"] = *h.WithdrawalsHash
+	}
+	return result
+}
+
+// RPCMarshalWithdrawals attaches the block's withdrawal list under the
+// "withdrawals" key used by eth_getBlockByNumber/ByHash once Shanghai is
+// active; pre-Shanghai blocks simply omit the key.
+func RPCMarshalWithdrawals(fields map[string]interface{}, withdrawals Withdrawals) {
+	if withdrawals == nil {
+		return
+	}
+	fields["withdrawals"] = withdrawals
+}
+
+// BuildBlockResponse assembles the full eth_getBlockByNumber/ByHash response
+// for h: it backfills WithdrawalsHash so a replayed historical header hashes
+// exactly as it originally did, then applies RPCMarshalHeader and
+// RPCMarshalWithdrawals on top. This is the one call path that ties those
+// three pieces together; legacyCosmosHash should come from whether the node
+// was started with the FlagLegacyCosmosHash flag. The eth_getBlock* JSON-RPC
+// handlers that would call this aren't part of this tree yet.
+func BuildBlockResponse(h *EthHeader, withdrawals Withdrawals, isMerge, legacyCosmosHash bool, shanghaiHeight int64) map[string]interface{} {
+	BackfillWithdrawalsHash(h, h.Number.Int64(), shanghaiHeight)
+
+	fields := RPCMarshalHeader(h, isMerge, legacyCosmosHash)
+	RPCMarshalWithdrawals(fields, withdrawals)
+	return fields
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWithdrawalJSONHexQuantities asserts that Withdrawal.Index/Validator/
+// Amount encode as 0x-prefixed hex quantities, not plain JSON numbers, and
+// that the encoding round-trips through UnmarshalJSON - a regression here
+// would mean the withdrawalJSON hex encoding is no longer taking effect.
+// TestRPCMarshalWithdrawalsHexQuantities checks the same encoding through
+// RPCMarshalWithdrawals' output.
+func TestWithdrawalJSONHexQuantities(t *testing.T) {
+	w := &Withdrawal{
+		Index:     1,
+		Validator: 2,
+		Address:   common.HexToAddress("0xaa"),
+		Amount:    3,
+	}
+
+	bz, err := json.Marshal(w)
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Equal(t, "0x1", decoded["index"])
+	require.Equal(t, "0x2", decoded["validatorIndex"])
+	require.Equal(t, "0x3", decoded["amount"])
+
+	var roundTripped Withdrawal
+	require.NoError(t, json.Unmarshal(bz, &roundTripped))
+	require.Equal(t, *w, roundTripped)
+}
+
+func TestRPCMarshalWithdrawalsHexQuantities(t *testing.T) {
+	fields := map[string]interface{}{}
+	RPCMarshalWithdrawals(fields, Withdrawals{{Index: 7, Validator: 8, Amount: 9}})
+
+	bz, err := json.Marshal(fields)
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+
+	withdrawals, ok := decoded["withdrawals"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, withdrawals, 1)
+
+	w0, ok := withdrawals[0].(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, "0x7", w0["index"])
+	require.Equal(t, "0x9", w0["amount"])
+}
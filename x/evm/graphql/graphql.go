@@ -0,0 +1,487 @@
+package graphql
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// Backend is the subset of the JSON-RPC backend the GraphQL resolvers need:
+// reads against the EVM keeper and the CometBFT tx index.
+type Backend interface {
+	HeaderByNumber(ctx context.Context, number int64) (*rpctypes.EthHeader, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*rpctypes.EthHeader, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*gethtypes.Transaction, *rpctypes.EthHeader, int, error)
+	TransactionsByHeader(ctx context.Context, header *rpctypes.EthHeader) (gethtypes.Transactions, error)
+	ReceiptByHash(ctx context.Context, hash common.Hash) (*gethtypes.Receipt, error)
+	// BlockLogs returns every log produced while executing the block's
+	// transactions, ordered by TxIndex ascending (and by log index within a
+	// transaction), i.e. the cumulative log slice.
+	BlockLogs(ctx context.Context, header *rpctypes.EthHeader) ([]*gethtypes.Log, error)
+	BalanceAt(ctx context.Context, address common.Address, header *rpctypes.EthHeader) (*hexutil.Big, error)
+	CodeAt(ctx context.Context, address common.Address, header *rpctypes.EthHeader) (hexutil.Bytes, error)
+	Call(ctx context.Context, data hexutil.Bytes, header *rpctypes.EthHeader) (*CallResult, error)
+	EstimateGas(ctx context.Context, data hexutil.Bytes, header *rpctypes.EthHeader) (hexutil.Uint64, error)
+}
+
+// CallResult is the outcome of an eth_call-equivalent GraphQL `call` query.
+type CallResult struct {
+	data    hexutil.Bytes
+	gasUsed Long
+	status  Long
+}
+
+func (c *CallResult) Data() hexutil.Bytes { return c.data }
+func (c *CallResult) GasUsed() Long       { return c.gasUsed }
+func (c *CallResult) Status() Long        { return c.status }
+
+// Resolver is the root GraphQL resolver for the EVM module's schema.
+type Resolver struct {
+	backend Backend
+}
+
+// NewResolver returns a Resolver backed by the given Backend.
+func NewResolver(backend Backend) *Resolver {
+	return &Resolver{backend: backend}
+}
+
+// Account resolves the `account` query.
+func (r *Resolver) Account(ctx context.Context, args struct {
+	Address     common.Address
+	BlockNumber *Long
+}) (*Account, error) {
+	header, err := r.headerForNumberArg(ctx, args.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{r: r, address: args.Address, header: header}, nil
+}
+
+// Block resolves the `block` query, by number or by hash.
+func (r *Resolver) Block(ctx context.Context, args struct {
+	Number *Long
+	Hash   *common.Hash
+}) (*Block, error) {
+	switch {
+	case args.Hash != nil:
+		header, err := r.backend.HeaderByHash(ctx, *args.Hash)
+		if err != nil {
+			return nil, err
+		}
+		return &Block{r: r, header: header}, nil
+	case args.Number != nil:
+		header, err := r.backend.HeaderByNumber(ctx, args.Number.Int64())
+		if err != nil {
+			return nil, err
+		}
+		return &Block{r: r, header: header}, nil
+	default:
+		header, err := r.backend.HeaderByNumber(ctx, -1) // latest
+		if err != nil {
+			return nil, err
+		}
+		return &Block{r: r, header: header}, nil
+	}
+}
+
+// Transaction resolves the `transaction` query.
+func (r *Resolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*Transaction, error) {
+	tx, header, index, err := r.backend.TransactionByHash(ctx, args.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{r: r, tx: tx, header: header, index: index}, nil
+}
+
+// Logs resolves the top-level `logs` query against a filter, applying
+// FromBlock/ToBlock as an inclusive block range and Addresses/Topics as a
+// post-filter over each block's logs, with eth_getLogs' address and topic
+// matching. An omitted FromBlock means the latest block; an omitted ToBlock
+// ends the range at FromBlock, not at the latest block.
+func (r *Resolver) Logs(ctx context.Context, args struct{ Filter FilterCriteria }) ([]*Log, error) {
+	from, err := r.headerForNumberArg(ctx, args.Filter.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	toNumber := from.Number.Int64()
+	if args.Filter.ToBlock != nil {
+		toNumber = args.Filter.ToBlock.Int64()
+	}
+
+	var matched []*gethtypes.Log
+	for number := from.Number.Int64(); number <= toNumber; number++ {
+		header, err := r.backend.HeaderByNumber(ctx, number)
+		if err != nil {
+			return nil, err
+		}
+		logs, err := r.backend.BlockLogs(ctx, header)
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range logs {
+			if logMatchesFilter(log, args.Filter) {
+				matched = append(matched, log)
+			}
+		}
+	}
+
+	return wrapLogs(r, matched, nil), nil
+}
+
+// FilterCriteria mirrors the GraphQL FilterCriteria input type.
+type FilterCriteria struct {
+	FromBlock *Long
+	ToBlock   *Long
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+func (r *Resolver) headerForNumberArg(ctx context.Context, number *Long) (*rpctypes.EthHeader, error) {
+	if number == nil {
+		return r.backend.HeaderByNumber(ctx, -1)
+	}
+	return r.backend.HeaderByNumber(ctx, number.Int64())
+}
+
+// Account is the GraphQL Account type resolver.
+type Account struct {
+	r       *Resolver
+	address common.Address
+	header  *rpctypes.EthHeader
+}
+
+func (a *Account) Address() common.Address { return a.address }
+
+func (a *Account) Balance(ctx context.Context) (BigInt, error) {
+	b, err := a.r.backend.BalanceAt(ctx, a.address, a.header)
+	if err != nil {
+		return BigInt{}, err
+	}
+	return newBigInt(b.ToInt()), nil
+}
+
+func (a *Account) Code(ctx context.Context) (hexutil.Bytes, error) {
+	return a.r.backend.CodeAt(ctx, a.address, a.header)
+}
+
+// Block is the GraphQL Block type resolver.
+type Block struct {
+	r      *Resolver
+	header *rpctypes.EthHeader
+}
+
+func (b *Block) Number() Long       { return Long(b.header.Number.Int64()) }
+func (b *Block) Hash() common.Hash { return b.header.Hash() }
+
+// CometHash exposes the CosmosHeaderHash fix from the hash-splitting change,
+// for clients that specifically need the CometBFT block hash.
+func (b *Block) CometHash() common.Hash { return b.header.CometHash() }
+
+func (b *Block) WithdrawalsRoot() *common.Hash { return b.header.WithdrawalsHash }
+
+func (b *Block) Parent(ctx context.Context) (*Block, error) {
+	if b.header.Number.Sign() == 0 {
+		return nil, nil
+	}
+	parent, err := b.r.backend.HeaderByHash(ctx, b.header.ParentHash)
+	if err != nil {
+		return nil, err
+	}
+	return &Block{r: b.r, header: parent}, nil
+}
+
+func (b *Block) Transactions(ctx context.Context) (*[]*Transaction, error) {
+	txs, err := b.r.backend.TransactionsByHeader(ctx, b.header)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Transaction, len(txs))
+	for i, tx := range txs {
+		out[i] = &Transaction{r: b.r, tx: tx, header: b.header, index: i}
+	}
+	return &out, nil
+}
+
+func (b *Block) TransactionCount(ctx context.Context) (*int32, error) {
+	txs, err := b.r.backend.TransactionsByHeader(ctx, b.header)
+	if err != nil {
+		return nil, err
+	}
+	count := int32(len(txs))
+	return &count, nil
+}
+
+func (b *Block) TransactionAt(ctx context.Context, args struct{ Index int32 }) (*Transaction, error) {
+	txs, err := b.r.backend.TransactionsByHeader(ctx, b.header)
+	if err != nil {
+		return nil, err
+	}
+	if args.Index < 0 || int(args.Index) >= len(txs) {
+		return nil, nil
+	}
+	return &Transaction{r: b.r, tx: txs[args.Index], header: b.header, index: int(args.Index)}, nil
+}
+
+// Transaction is the GraphQL Transaction type resolver.
+type Transaction struct {
+	r      *Resolver
+	tx     *gethtypes.Transaction
+	header *rpctypes.EthHeader
+	index  int
+}
+
+func (t *Transaction) Hash() common.Hash { return t.tx.Hash() }
+func (t *Transaction) Nonce() Long       { return Long(t.tx.Nonce()) }
+func (t *Transaction) Value() BigInt     { return newBigInt(t.tx.Value()) }
+func (t *Transaction) Index() int32      { return int32(t.index) }
+
+func (t *Transaction) Block() *Block { return &Block{r: t.r, header: t.header} }
+
+// From resolves the Transaction.from field: the sender recovered from the
+// transaction's signature.
+func (t *Transaction) From() (*Account, error) {
+	signer := gethtypes.LatestSignerForChainID(t.tx.ChainId())
+	sender, err := gethtypes.Sender(signer, t.tx)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{r: t.r, address: sender, header: t.header}, nil
+}
+
+// To resolves the Transaction.to field, returning nil for a
+// contract-creation transaction, the same as the JSON-RPC "to" field.
+func (t *Transaction) To() *Account {
+	to := t.tx.To()
+	if to == nil {
+		return nil
+	}
+	return &Account{r: t.r, address: *to, header: t.header}
+}
+
+// GasUsed resolves the Transaction.gasUsed field from the transaction's
+// receipt; it returns nil if the transaction hasn't been executed yet.
+func (t *Transaction) GasUsed(ctx context.Context) (*Long, error) {
+	receipt, err := t.r.backend.ReceiptByHash(ctx, t.tx.Hash())
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	gasUsed := Long(receipt.GasUsed)
+	return &gasUsed, nil
+}
+
+// Status resolves the Transaction.status field from the transaction's
+// receipt; it returns nil if the transaction hasn't been executed yet.
+func (t *Transaction) Status(ctx context.Context) (*Long, error) {
+	receipt, err := t.r.backend.ReceiptByHash(ctx, t.tx.Hash())
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	status := Long(receipt.Status)
+	return &status, nil
+}
+
+// Logs resolves the Transaction.logs field. Rather than scanning the whole
+// block's cumulative log slice for entries with this tx's index (O(n*m)
+// across n transactions and m logs), it binary searches for the first log
+// at this tx's index and then walks forward while the index still matches:
+// the slice is already ordered by TxIndex, so both ends of the matching run
+// are found in O(log m) plus the (typically tiny) run length.
+func (t *Transaction) Logs(ctx context.Context) (*[]*Log, error) {
+	logs, err := t.r.backend.BlockLogs(ctx, t.header)
+	if err != nil {
+		return nil, err
+	}
+
+	start := sort.Search(len(logs), func(i int) bool {
+		return int(logs[i].TxIndex) >= t.index
+	})
+
+	var matched []*gethtypes.Log
+	for i := start; i < len(logs) && int(logs[i].TxIndex) == t.index; i++ {
+		matched = append(matched, logs[i])
+	}
+
+	result := wrapLogs(t.r, matched, t)
+	return &result, nil
+}
+
+// Log is the GraphQL Log type resolver.
+type Log struct {
+	r   *Resolver
+	log *gethtypes.Log
+	tx  *Transaction
+}
+
+func wrapLogs(r *Resolver, logs []*gethtypes.Log, tx *Transaction) []*Log {
+	wrapped := make([]*Log, len(logs))
+	for i, l := range logs {
+		wrapped[i] = &Log{r: r, log: l, tx: tx}
+	}
+	return wrapped
+}
+
+func (l *Log) Index() int32 { return int32(l.log.Index) }
+
+func (l *Log) Account() *Account {
+	return &Account{r: l.r, address: l.log.Address}
+}
+
+func (l *Log) Topics() []common.Hash { return l.log.Topics }
+func (l *Log) Data() hexutil.Bytes   { return l.log.Data }
+
+func (l *Log) Transaction(ctx context.Context) (*Transaction, error) {
+	if l.tx != nil {
+		return l.tx, nil
+	}
+	tx, header, index, err := l.r.backend.TransactionByHash(ctx, l.log.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{r: l.r, tx: tx, header: header, index: index}, nil
+}
+
+// Call resolves the `call` query, an eth_call equivalent.
+func (r *Resolver) Call(ctx context.Context, args struct {
+	Data        hexutil.Bytes
+	BlockNumber *Long
+}) (*CallResult, error) {
+	header, err := r.headerForNumberArg(ctx, args.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return r.backend.Call(ctx, args.Data, header)
+}
+
+// EstimateGas resolves the `estimateGas` query.
+func (r *Resolver) EstimateGas(ctx context.Context, args struct {
+	Data        hexutil.Bytes
+	BlockNumber *Long
+}) (Long, error) {
+	header, err := r.headerForNumberArg(ctx, args.BlockNumber)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := r.backend.EstimateGas(ctx, args.Data, header)
+	return Long(gas), err
+}
+
+// Subscription is the GraphQL root subscription resolver. It streams
+// newHeads and logs over whatever websocket transport the GraphQL HTTP
+// handler is served behind, fed by the same Hub the JSON-RPC pubsub uses.
+type Subscription struct {
+	r   *Resolver
+	hub *Hub
+}
+
+// NewSubscription returns a Subscription resolver fed by hub.
+func NewSubscription(r *Resolver, hub *Hub) *Subscription {
+	return &Subscription{r: r, hub: hub}
+}
+
+// NewHeads streams every newly produced block header until ctx is canceled.
+func (s *Subscription) NewHeads(ctx context.Context) <-chan *Block {
+	headers := s.hub.subscribeHeads()
+	out := make(chan *Block)
+
+	go func() {
+		defer s.hub.unsubscribeHeads(headers)
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case header, ok := <-headers:
+				if !ok {
+					return
+				}
+				select {
+				case out <- &Block{r: s.r, header: header}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// NewLogs streams logs matching filter as they're produced, until ctx is
+// canceled.
+func (s *Subscription) NewLogs(ctx context.Context, args struct{ Filter FilterCriteria }) <-chan *Log {
+	logs := s.hub.subscribeLogs()
+	out := make(chan *Log)
+
+	go func() {
+		defer s.hub.unsubscribeLogs(logs)
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case log, ok := <-logs:
+				if !ok {
+					return
+				}
+				if !logMatchesFilter(log, args.Filter) {
+					continue
+				}
+				select {
+				case out <- &Log{r: s.r, log: log}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// logMatchesFilter reports whether log satisfies filter's Addresses and
+// Topics criteria (block range, if any, is applied by the caller). Topics
+// matching follows eth_getLogs semantics: position i in filter.Topics is an
+// OR-set of acceptable values for log.Topics[i], and a nil or empty set at
+// that position matches any topic.
+func logMatchesFilter(log *gethtypes.Log, filter FilterCriteria) bool {
+	if filter.Addresses != nil {
+		matched := false
+		for _, addr := range *filter.Addresses {
+			if log.Address == addr {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	if filter.Topics != nil {
+		topics := *filter.Topics
+		if len(topics) > len(log.Topics) {
+			return false
+		}
+		for i, wanted := range topics {
+			if len(wanted) == 0 {
+				continue
+			}
+			matched := false
+			for _, want := range wanted {
+				if log.Topics[i] == want {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,83 @@
+package graphql
+
+import (
+	"sync"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// Hub fans block headers and logs out to GraphQL subscribers. It has no
+// source of its own: subscribers only receive what is passed to
+// NotifyNewHead/NotifyLogs, and nothing in this module, EndBlocker included,
+// calls them.
+type Hub struct {
+	mu       sync.Mutex
+	headSubs map[chan *rpctypes.EthHeader]struct{}
+	logSubs  map[chan *gethtypes.Log]struct{}
+}
+
+// NewHub returns an empty Hub.
+func NewHub() *Hub {
+	return &Hub{
+		headSubs: make(map[chan *rpctypes.EthHeader]struct{}),
+		logSubs:  make(map[chan *gethtypes.Log]struct{}),
+	}
+}
+
+// NotifyNewHead broadcasts header to every active newHeads subscriber.
+func (h *Hub) NotifyNewHead(header *rpctypes.EthHeader) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.headSubs {
+		select {
+		case ch <- header:
+		default:
+		}
+	}
+}
+
+// NotifyLogs broadcasts each log to every active logs subscriber.
+func (h *Hub) NotifyLogs(logs []*gethtypes.Log) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, log := range logs {
+		for ch := range h.logSubs {
+			select {
+			case ch <- log:
+			default:
+			}
+		}
+	}
+}
+
+func (h *Hub) subscribeHeads() chan *rpctypes.EthHeader {
+	ch := make(chan *rpctypes.EthHeader, 16)
+	h.mu.Lock()
+	h.headSubs[ch] = struct{}{}
+	h.mu.Unlock()
+	return ch
+}
+
+func (h *Hub) unsubscribeHeads(ch chan *rpctypes.EthHeader) {
+	h.mu.Lock()
+	delete(h.headSubs, ch)
+	h.mu.Unlock()
+	close(ch)
+}
+
+func (h *Hub) subscribeLogs() chan *gethtypes.Log {
+	ch := make(chan *gethtypes.Log, 16)
+	h.mu.Lock()
+	h.logSubs[ch] = struct{}{}
+	h.mu.Unlock()
+	return ch
+}
+
+func (h *Hub) unsubscribeLogs(ch chan *gethtypes.Log) {
+	h.mu.Lock()
+	delete(h.logSubs, ch)
+	h.mu.Unlock()
+	close(ch)
+}
@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Long is the Go representation of the schema's "Long" scalar (a 64-bit
+// integer). graph-gophers/graphql-go marshals a custom scalar via the Go
+// value's own JSON encoding, and a plain int64 alias encodes as a bare JSON
+// number - which is what go-ethereum's own core/graphql package returns for
+// fields like nonce/gasUsed/status. hexutil.Uint64, by contrast, MarshalText
+// produces a quoted "0x..." string: the right wire format for JSON-RPC, but
+// not for this scalar. Do not give Long a MarshalJSON of its own.
+type Long int64
+
+// Int64 returns l as a plain int64, for call sites (e.g. HeaderByNumber)
+// that take a block height rather than a GraphQL scalar.
+func (l Long) Int64() int64 { return int64(l) }
+
+// ImplementsGraphQLType returns true if Long implements the provided GraphQL type.
+func (l Long) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query variable into l.
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		// Numbers too large for float64 to round-trip often arrive as a
+		// 0x-prefixed string instead.
+		value, err := hexutil.DecodeUint64(input)
+		*l = Long(value)
+		return err
+	case int32:
+		*l = Long(input)
+		return nil
+	case int64:
+		*l = Long(input)
+		return nil
+	case float64:
+		*l = Long(input)
+		return nil
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+}
+
+// BigInt is the Go representation of the schema's "BigInt" scalar (an
+// arbitrary-precision integer), used for fields like balance/value/
+// difficulty. Its underlying type is big.Int, and its MarshalJSON defers to
+// big.Int's own - decimal digits, unquoted - again matching
+// go-ethereum's core/graphql rather than the hexutil.Big JSON-RPC
+// convention of a quoted hex string.
+type BigInt big.Int
+
+// ImplementsGraphQLType returns true if BigInt implements the provided GraphQL type.
+func (b BigInt) ImplementsGraphQLType(name string) bool { return name == "BigInt" }
+
+// UnmarshalGraphQL unmarshals the provided GraphQL query variable into b.
+func (b *BigInt) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		n, ok := new(big.Int).SetString(input, 10)
+		if !ok {
+			return fmt.Errorf("invalid syntax for BigInt: %q", input)
+		}
+		*(*big.Int)(b) = *n
+		return nil
+	case int32:
+		(*big.Int)(b).SetInt64(int64(input))
+		return nil
+	case int64:
+		(*big.Int)(b).SetInt64(input)
+		return nil
+	default:
+		return fmt.Errorf("unexpected type %T for BigInt", input)
+	}
+}
+
+// MarshalJSON defers to big.Int's own encoding (bare decimal digits).
+func (b BigInt) MarshalJSON() ([]byte, error) {
+	return (*big.Int)(&b).MarshalJSON()
+}
+
+// newBigInt converts v into a BigInt scalar, treating a nil v as zero the
+// same way the fields that feed it (e.g. a block with no baseFeePerGas)
+// would.
+func newBigInt(v *big.Int) BigInt {
+	if v == nil {
+		return BigInt{}
+	}
+	return BigInt(*v)
+}
@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLongMarshalsAsBareNumber guards against Long regaining a hex
+// MarshalJSON: graph-gophers/graphql-go serializes a custom scalar via the
+// Go value's own JSON encoding, and the Long GraphQL scalar (nonce, gasUsed,
+// status, ...) must come back as a bare number like go-ethereum's own
+// core/graphql, not a quoted "0x..." hexutil.Uint64 string.
+func TestLongMarshalsAsBareNumber(t *testing.T) {
+	bz, err := json.Marshal(Long(21000))
+	require.NoError(t, err)
+	require.Equal(t, "21000", string(bz))
+}
+
+// TestBigIntMarshalsAsBareDecimal guards the same property for BigInt
+// (balance, value, ...): the wire format is unquoted decimal digits, not a
+// quoted hex string.
+func TestBigIntMarshalsAsBareDecimal(t *testing.T) {
+	bz, err := json.Marshal(newBigInt(big.NewInt(123456789)))
+	require.NoError(t, err)
+	require.Equal(t, "123456789", string(bz))
+}
+
+func TestNewBigIntNilIsZero(t *testing.T) {
+	bz, err := json.Marshal(newBigInt(nil))
+	require.NoError(t, err)
+	require.Equal(t, "0", string(bz))
+}
+
+func TestLongUnmarshalGraphQL(t *testing.T) {
+	var l Long
+	require.NoError(t, l.UnmarshalGraphQL(int32(5)))
+	require.Equal(t, Long(5), l)
+
+	require.NoError(t, l.UnmarshalGraphQL("0x2a"))
+	require.Equal(t, Long(42), l)
+
+	require.Error(t, l.UnmarshalGraphQL(true))
+}
+
+func TestBigIntUnmarshalGraphQL(t *testing.T) {
+	var b BigInt
+	require.NoError(t, b.UnmarshalGraphQL("123"))
+	require.Equal(t, big.NewInt(123), (*big.Int)(&b))
+
+	require.Error(t, b.UnmarshalGraphQL("not-a-number"))
+	require.Error(t, b.UnmarshalGraphQL(true))
+}
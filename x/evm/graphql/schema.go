@@ -0,0 +1,86 @@
+package graphql
+
+// schema is the GraphQL schema for the EVM module's query endpoint. It is
+// intentionally a subset of go-ethereum's schema (core/graphql kept in sync
+// with the full JSON-RPC surface); fields are added here as resolvers exist
+// for them, the same way this module's JSON-RPC namespaces grew over time.
+const schema = `
+  scalar Bytes32
+  scalar Address
+  scalar Bytes
+  scalar BigInt
+  scalar Long
+
+  schema {
+    query: Query
+    subscription: Subscription
+  }
+
+  type Account {
+    address: Address!
+    balance: BigInt!
+    code: Bytes!
+  }
+
+  type Log {
+    index: Int!
+    account: Account!
+    topics: [Bytes32!]!
+    data: Bytes!
+    transaction: Transaction!
+  }
+
+  type Transaction {
+    hash: Bytes32!
+    nonce: Long!
+    from: Account!
+    to: Account
+    value: BigInt!
+    gasUsed: Long
+    status: Long
+    logs: [Log!]
+    block: Block!
+    index: Int!
+  }
+
+  type Block {
+    number: Long!
+    hash: Bytes32!
+    cometHash: Bytes32!
+    parent: Block
+    transactionCount: Int
+    transactions: [Transaction!]
+    transactionAt(index: Int!): Transaction
+    withdrawalsRoot: Bytes32
+  }
+
+  type CallResult {
+    data: Bytes!
+    gasUsed: Long!
+    status: Long!
+  }
+
+  input FilterCriteria {
+    fromBlock: Long
+    toBlock: Long
+    addresses: [Address!]
+    topics: [[Bytes32!]]
+  }
+
+  type Query {
+    block(number: Long, hash: Bytes32): Block
+    transaction(hash: Bytes32!): Transaction
+    logs(filter: FilterCriteria!): [Log!]!
+    account(address: Address!, blockNumber: Long): Account!
+    call(data: Bytes!, blockNumber: Long): CallResult!
+    estimateGas(data: Bytes!, blockNumber: Long): Long!
+  }
+
+  type Subscription {
+    newHeads: Block!
+    # Named distinctly from Query.logs: a single resolver method can't serve
+    # both a list-returning query field and a channel-returning subscription
+    # field under the same Go method name.
+    newLogs(filter: FilterCriteria!): Log!
+  }
+`
@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"net/http"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+)
+
+// Service exposes the EVM module's GraphQL endpoint at /graphql, alongside
+// the existing JSON-RPC server. Handler is a plain relay.Handler that serves
+// queries over HTTP; it has no websocket transport, so the schema's
+// Subscription fields cannot be reached through it.
+type Service struct {
+	Handler http.Handler
+	Hub     *Hub
+}
+
+// New builds the GraphQL Service for backend. Callers should register
+// Service.Handler at the "/graphql" path on the node's HTTP mux.
+func New(backend Backend) (*Service, error) {
+	hub := NewHub()
+	resolver := NewResolver(backend)
+
+	parsedSchema, err := graphqlgo.ParseSchema(schema, &rootResolver{
+		Resolver:     resolver,
+		Subscription: NewSubscription(resolver, hub),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		Handler: &relay.Handler{Schema: parsedSchema},
+		Hub:     hub,
+	}, nil
+}
+
+// rootResolver satisfies graphql-go's requirement that query and
+// subscription fields both be resolvable from a single root value.
+type rootResolver struct {
+	*Resolver
+	*Subscription
+}
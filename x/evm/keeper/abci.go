@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// EndBlocker is the per-block chokepoint that ties this module's
+// post-merge and withdrawals support together: it stamps header with
+// post-merge Difficulty/PREVRANDAO rules, backfills WithdrawalsHash for
+// pre-Shanghai headers, verifies withdrawals against the (possibly
+// backfilled) WithdrawalsHash before crediting them, and finally indexes the
+// resulting Ethereum-canonical hash against the header's CometBFT hash so
+// either can later be used to look the block up through the JSON-RPC
+// backend.
+//
+// isMerge and shanghaiHeight gate the post-merge and post-Shanghai rules the
+// same way go-ethereum's own ChainConfig height checks do; lastCommitHash
+// feeds PrevRandao since there is no beacon-chain RANDAO to draw from.
+func (k Keeper) EndBlocker(
+	ctx sdk.Context,
+	header *rpctypes.EthHeader,
+	withdrawals rpctypes.Withdrawals,
+	evmDenom string,
+	isMerge bool,
+	lastCommitHash []byte,
+	shanghaiHeight int64,
+) error {
+	header.ApplyMergeRules(isMerge, PrevRandao(lastCommitHash))
+	rpctypes.BackfillWithdrawalsHash(header, header.Number.Int64(), shanghaiHeight)
+
+	if err := header.VerifyWithdrawalsHash(withdrawals); err != nil {
+		return err
+	}
+
+	if err := k.ApplyWithdrawals(ctx, withdrawals, evmDenom); err != nil {
+		return err
+	}
+
+	k.SetHashMapping(ctx, header.Hash(), header.CometHash())
+	return nil
+}
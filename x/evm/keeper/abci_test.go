@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// TestEndBlockerIndexesHash asserts that EndBlocker's three pieces - the
+// post-merge rewrite, the withdrawals-hash backfill/verify, and the hash
+// index - actually compose: the header handed back from Hash()/CometHash()
+// after EndBlocker runs is the one indexed, and it's reachable by either
+// hash afterwards.
+func TestEndBlockerIndexesHash(t *testing.T) {
+	k, ctx, bank := newTestKeeper(t)
+
+	header := &rpctypes.EthHeader{
+		Number:     big.NewInt(1),
+		Difficulty: big.NewInt(123456),
+		GasLimit:   30_000_000,
+		Extra:      []byte{},
+	}
+
+	const shanghaiHeight = 100 // header predates Shanghai: no withdrawals expected
+	err := k.EndBlocker(ctx, header, nil, "stake", true, []byte("last-commit"), shanghaiHeight)
+	require.NoError(t, err)
+
+	// The post-merge rule must have actually run before hashing/indexing.
+	require.Equal(t, big.NewInt(0), header.Difficulty)
+	require.Equal(t, PrevRandao([]byte("last-commit")), header.MixDigest)
+	require.Nil(t, header.WithdrawalsHash)
+
+	ethHash := header.Hash()
+	cometHash := header.CometHash()
+
+	got, found := k.GetCometHashByEthHash(ctx, ethHash)
+	require.True(t, found)
+	require.Equal(t, cometHash, got)
+
+	gotEth, found := k.GetEthHashByCometHash(ctx, cometHash)
+	require.True(t, found)
+	require.Equal(t, ethHash, gotEth)
+
+	// No withdrawals were provided, so the bank keeper should never have
+	// been touched.
+	require.Empty(t, bank.callLog)
+}
+
+// TestEndBlockerRejectsWithdrawalsHashMismatch asserts that EndBlocker
+// surfaces VerifyWithdrawalsHash's error instead of indexing a header whose
+// withdrawals don't match its WithdrawalsHash.
+func TestEndBlockerRejectsWithdrawalsHashMismatch(t *testing.T) {
+	k, ctx, _ := newTestKeeper(t)
+
+	mismatched := common.HexToHash("0x1234")
+	header := &rpctypes.EthHeader{
+		Number:          big.NewInt(500),
+		Difficulty:      big.NewInt(0),
+		Extra:           []byte{},
+		WithdrawalsHash: &mismatched,
+	}
+
+	withdrawals := rpctypes.Withdrawals{{Index: 1, Validator: 1, Amount: 1}}
+	err := k.EndBlocker(ctx, header, withdrawals, "stake", true, []byte("last-commit"), 0)
+	require.Error(t, err)
+
+	_, found := k.GetCometHashByEthHash(ctx, header.Hash())
+	require.False(t, found)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Key prefixes for the bidirectional Ethereum-hash <-> CometBFT-hash index
+// populated once per block in EndBlocker.
+var (
+	KeyPrefixEthHashToCometHash = []byte{0x10}
+	KeyPrefixCometHashToEthHash = []byte{0x11}
+)
+
+// SetHashMapping records that ethHash and cometHash identify the same block,
+// indexed in both directions so either hash can be used to look up the
+// other.
+func (k Keeper) SetHashMapping(ctx sdk.Context, ethHash, cometHash common.Hash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append(KeyPrefixEthHashToCometHash, ethHash.Bytes()...), cometHash.Bytes())
+	store.Set(append(KeyPrefixCometHashToEthHash, cometHash.Bytes()...), ethHash.Bytes())
+}
+
+// GetCometHashByEthHash looks up the CometBFT block hash for a given
+// Ethereum-canonical block hash, returning false if it isn't indexed (e.g.
+// the block predates this index being introduced).
+func (k Keeper) GetCometHashByEthHash(ctx sdk.Context, ethHash common.Hash) (common.Hash, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(append(KeyPrefixEthHashToCometHash, ethHash.Bytes()...))
+	if bz == nil {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz), true
+}
+
+// GetEthHashByCometHash looks up the Ethereum-canonical block hash for a
+// given CometBFT block hash, returning false if it isn't indexed.
+func (k Keeper) GetEthHashByCometHash(ctx sdk.Context, cometHash common.Hash) (common.Hash, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(append(KeyPrefixCometHashToEthHash, cometHash.Bytes()...))
+	if bz == nil {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(bz), true
+}
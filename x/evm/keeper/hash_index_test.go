@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSetHashMappingRoundTrip asserts SetHashMapping indexes both
+// directions: the Ethereum hash resolves to the CometBFT hash and vice
+// versa.
+func TestSetHashMappingRoundTrip(t *testing.T) {
+	k, ctx, _ := newTestKeeper(t)
+
+	ethHash := common.HexToHash("0xaa")
+	cometHash := common.HexToHash("0xbb")
+
+	k.SetHashMapping(ctx, ethHash, cometHash)
+
+	got, found := k.GetCometHashByEthHash(ctx, ethHash)
+	require.True(t, found)
+	require.Equal(t, cometHash, got)
+
+	gotEth, found := k.GetEthHashByCometHash(ctx, cometHash)
+	require.True(t, found)
+	require.Equal(t, ethHash, gotEth)
+}
+
+func TestGetCometHashByEthHashNotIndexed(t *testing.T) {
+	k, ctx, _ := newTestKeeper(t)
+
+	_, found := k.GetCometHashByEthHash(ctx, common.HexToHash("0xdeadbeef"))
+	require.False(t, found)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected account keeper interface used by the
+// EVM keeper to resolve the Cosmos account backing an Ethereum address.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the expected bank keeper interface used by the EVM
+// keeper to credit and debit the EVM denom.
+type BankKeeper interface {
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// Keeper implements the x/evm state transition logic and provides the store
+// access the EVM needs: accounts, code, storage, and the auxiliary indices
+// added on top (hash mappings, precompile registry, ...).
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	accountKeeper AccountKeeper
+	bankKeeper    BankKeeper
+
+	// authority is the address permitted to submit gov-gated precompile
+	// registry messages (MsgRegisterPrecompile/MsgUpdatePrecompile),
+	// ordinarily the x/gov module account.
+	authority string
+
+	// withdrawalsGweiToDenomMultiplier is the factor WithdrawalsGweiToDenomMultiplier
+	// applies to a withdrawal's gwei-denominated Amount to express it in the
+	// EVM denom. Chains whose EVM denom isn't 18-decimals wei pass a
+	// different value to NewKeeper; DefaultWithdrawalsGweiToDenomMultiplier
+	// is correct for 18-decimal denoms.
+	withdrawalsGweiToDenomMultiplier sdkmath.Int
+}
+
+// DefaultWithdrawalsGweiToDenomMultiplier converts a withdrawal Amount
+// (always gwei-denominated at the consensus layer) into wei, correct for any
+// chain whose EVM denom has 18 decimals.
+var DefaultWithdrawalsGweiToDenomMultiplier = sdkmath.NewInt(1_000_000_000)
+
+// NewKeeper creates a new x/evm Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	ak AccountKeeper,
+	bk BankKeeper,
+	authority string,
+	withdrawalsGweiToDenomMultiplier sdkmath.Int,
+) Keeper {
+	return Keeper{
+		cdc:                              cdc,
+		storeKey:                         storeKey,
+		accountKeeper:                    ak,
+		bankKeeper:                       bk,
+		authority:                        authority,
+		withdrawalsGweiToDenomMultiplier: withdrawalsGweiToDenomMultiplier,
+	}
+}
+
+// GetAuthority returns the address permitted to submit gov-gated precompile
+// registry messages.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// msgServer implements types.MsgServer, routing the module's gov-gated
+// precompile messages to the keeper after checking the caller is the
+// configured authority. Its shape matches what a protoc-generated MsgServer
+// would be; wiring an instance into baseapp's message router happens in
+// RegisterServices, which - like this module's AppModule and genesis - isn't
+// part of this tree yet.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the module's precompile
+// MsgServer backed by k.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+var _ types.MsgServer = (*msgServer)(nil)
+
+func (m msgServer) RegisterPrecompile(goCtx context.Context, msg *types.MsgRegisterPrecompile) (*types.MsgRegisterPrecompileResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, fmt.Errorf("invalid authority: expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := m.GetPrecompile(ctx, msg.Entry.Address); found {
+		return nil, fmt.Errorf("precompile already registered at address %s", msg.Entry.Address.Hex())
+	}
+	if err := m.SetPrecompile(ctx, msg.Entry); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterPrecompileResponse{}, nil
+}
+
+func (m msgServer) UpdatePrecompile(goCtx context.Context, msg *types.MsgUpdatePrecompile) (*types.MsgUpdatePrecompileResponse, error) {
+	if m.authority != msg.Authority {
+		return nil, fmt.Errorf("invalid authority: expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := m.GetPrecompile(ctx, msg.Entry.Address); !found {
+		return nil, fmt.Errorf("no precompile registered at address %s", msg.Entry.Address.Hex())
+	}
+	if err := m.SetPrecompile(ctx, msg.Entry); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdatePrecompileResponse{}, nil
+}
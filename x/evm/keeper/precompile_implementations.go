@@ -0,0 +1,26 @@
+package keeper
+
+// GasCallback prices a dynamic-gas precompile call from its input.
+type GasCallback func(input []byte) (uint64, error)
+
+// precompileGasCallbacks holds the in-process pricing functions for
+// implementations registered with GasScheduleDynamic. These can't live on
+// chain since they're Go code, not data: the on-chain registry stores only
+// the ImplementationID, and the node binary must have a matching callback
+// registered here for that ID, the same way it must have the implementation
+// itself compiled in.
+var precompileGasCallbacks = map[string]GasCallback{}
+
+// RegisterGasCallback registers the dynamic pricing function for a
+// precompile implementation. It should be called once at app wiring time,
+// before any block referencing that implementation is processed.
+func RegisterGasCallback(implementationID string, callback GasCallback) {
+	precompileGasCallbacks[implementationID] = callback
+}
+
+// GasCallbackFor returns the registered pricing function for
+// implementationID, if any.
+func GasCallbackFor(implementationID string) (GasCallback, bool) {
+	cb, ok := precompileGasCallbacks[implementationID]
+	return cb, ok
+}
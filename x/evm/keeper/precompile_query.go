@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// PrecompileParams answers the PrecompileParams(address, height) query: the
+// registry entry currently stored for address, provided it is active at
+// height, or an error if nothing is registered at address or the entry's
+// ActivationHeight is above height. Entries are not versioned, so an entry
+// that an update has since replaced is not returned for an earlier height.
+//
+// This is a plain keeper method, not a gRPC query service method: the
+// module has no protobuf-generated query types (see precompile_registry.go
+// for the same gap on the storage side), and no JSON-RPC or CLI query
+// command in this tree calls it.
+func (k Keeper) PrecompileParams(ctx sdk.Context, address common.Address, height int64) (types.PrecompileRegistryEntry, error) {
+	entry, found := k.GetPrecompile(ctx, address)
+	if !found {
+		return types.PrecompileRegistryEntry{}, fmt.Errorf("no precompile registered at address %s", address.Hex())
+	}
+	if !entry.IsActiveAt(height) {
+		return types.PrecompileRegistryEntry{}, fmt.Errorf("precompile at address %s is not active at height %d (activates at %d)", address.Hex(), height, entry.ActivationHeight)
+	}
+	return entry, nil
+}
+
+// ChargeGas computes the gas cost of calling the precompile registered at
+// address with input at height, resolving a dynamic pricing callback by the
+// entry's ImplementationID when its GasSchedule calls for one. Like
+// PrecompileParams, it height-gates on entry.IsActiveAt so a
+// registered-but-not-yet-active precompile can't be charged (and, once
+// wired into the interpreter, can't run) before its activation height.
+//
+// The interpreter's JumpTable/precompile dispatch is expected to call
+// ActivePrecompiles and this method at execution time so the registry
+// actually affects which addresses run as precompiles and what they cost;
+// that call site lives in the vm.EVM construction path, which - like this
+// module's AppModule and genesis wiring - isn't part of this tree yet.
+func (k Keeper) ChargeGas(ctx sdk.Context, address common.Address, input []byte, height int64) (uint64, error) {
+	entry, found := k.GetPrecompile(ctx, address)
+	if !found {
+		return 0, fmt.Errorf("no precompile registered at address %s", address.Hex())
+	}
+	if !entry.IsActiveAt(height) {
+		return 0, fmt.Errorf("precompile at address %s is not active at height %d (activates at %d)", address.Hex(), height, entry.ActivationHeight)
+	}
+
+	callback, _ := GasCallbackFor(entry.ImplementationID)
+	return entry.GasSchedule.Cost(input, callback)
+}
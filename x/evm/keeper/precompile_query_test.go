@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// TestChargeGasHeightGate asserts ChargeGas refuses to price a call against
+// a precompile that is registered but not yet active at height, the same
+// way PrecompileParams already does - otherwise a registered-but-inactive
+// precompile could still be charged (and, once wired into the interpreter,
+// executed) before its activation height.
+func TestChargeGasHeightGate(t *testing.T) {
+	k, ctx, _ := newTestKeeper(t)
+
+	addr := common.HexToAddress("0x1234")
+	require.NoError(t, k.SetPrecompile(ctx, types.PrecompileRegistryEntry{
+		Address:          addr,
+		ImplementationID: "identity",
+		GasSchedule:      types.GasSchedule{Kind: types.GasScheduleFlatPerCall, BaseCost: 15},
+		ActivationHeight: 100,
+	}))
+
+	_, err := k.ChargeGas(ctx, addr, nil, 50)
+	require.Error(t, err)
+
+	gas, err := k.ChargeGas(ctx, addr, nil, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), gas)
+}
+
+func TestChargeGasUnregisteredAddress(t *testing.T) {
+	k, ctx, _ := newTestKeeper(t)
+
+	_, err := k.ChargeGas(ctx, common.HexToAddress("0xdead"), nil, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// PrevRandao derives the post-merge PREVRANDAO value fed into the EVM's
+// DIFFICULTY/PREVRANDAO opcode (via vm.BlockContext.Random) and stamped onto
+// EthHeader.MixDigest. There is no beacon chain RANDAO here, so the value is
+// instead drawn from consensus data that is (a) unknown to the block
+// proposer ahead of time and (b) already part of the block: the last commit
+// hash. A chain that wants unpredictability tied to the proposer instead of
+// the validator set can swap this for a VRF over the proposer's signature.
+func PrevRandao(lastCommitHash []byte) common.Hash {
+	return crypto.Keccak256Hash(lastCommitHash)
+}
+
+// BlockContextRandom returns the value a vm.BlockContext's Random field
+// should be set to for header, so that the interpreter's DIFFICULTY opcode
+// reads PREVRANDAO post-merge instead of Difficulty: nil pre-merge (Random
+// unset, DIFFICULTY falls back to Difficulty as before), and a pointer to
+// header.MixDigest post-merge, since EndBlocker already stamps prevRandao
+// there via EthHeader.ApplyMergeRules. The vm.BlockContext constructor that
+// should read this - like the rest of this module's core/vm wiring - isn't
+// part of this tree yet.
+func BlockContextRandom(header *rpctypes.EthHeader, isMerge bool) *common.Hash {
+	if !isMerge {
+		return nil
+	}
+	return &header.MixDigest
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// ApplyTransactionWithEVM runs msg against an already-constructed evm and
+// assembles the resulting receipt, the same split go-ethereum uses between
+// ApplyTransaction (drives the state transition) and MakeReceipt (assembles
+// the receipt from its result). Exposing this split lets callers that have
+// already executed a transaction some other way - tracers, replay tooling,
+// and debug_traceTransaction - reuse receipt assembly instead of re-running
+// the transaction purely to reproduce its receipt.
+func ApplyTransactionWithEVM(
+	msg *gethcore.Message,
+	cfg *evmtypes.EVMConfig,
+	gp *gethcore.GasPool,
+	db *statedb.StateDB,
+	blockNumber *big.Int,
+	blockHash common.Hash,
+	tx *gethtypes.Transaction,
+	usedGas *uint64,
+	evm *vm.EVM,
+) (*gethtypes.Receipt, error) {
+	result, err := gethcore.ApplyMessage(evm, msg, gp)
+	if err != nil {
+		return nil, err
+	}
+
+	*usedGas += result.UsedGas
+
+	var root []byte
+	if cfg.ChainConfig.IsByzantium(blockNumber) {
+		db.Finalise(true)
+	} else {
+		root = db.IntermediateRoot(cfg.ChainConfig.IsEIP158(blockNumber)).Bytes()
+	}
+
+	receipt := MakeReceipt(evm, result, db, blockNumber, blockHash, tx, *usedGas, root)
+	return receipt, nil
+}
+
+// MakeReceipt assembles a transaction receipt from an already-executed
+// ExecutionResult, without re-running the transaction. A precompile that
+// wants to attach synthetic logs to its own receipt (see the precompile
+// registry) can do so by constructing its own *ExecutionResult and calling
+// this directly.
+func MakeReceipt(
+	evm *vm.EVM,
+	result *gethcore.ExecutionResult,
+	db *statedb.StateDB,
+	blockNumber *big.Int,
+	blockHash common.Hash,
+	tx *gethtypes.Transaction,
+	usedGas uint64,
+	root []byte,
+) *gethtypes.Receipt {
+	receipt := &gethtypes.Receipt{
+		Type:              tx.Type(),
+		PostState:         root,
+		CumulativeGasUsed: usedGas,
+		TxHash:            tx.Hash(),
+		GasUsed:           result.UsedGas,
+	}
+
+	if result.Failed() {
+		receipt.Status = gethtypes.ReceiptStatusFailed
+	} else {
+		receipt.Status = gethtypes.ReceiptStatusSuccessful
+	}
+
+	receipt.EffectiveGasPrice = effectiveGasPrice(tx, evm.Context.BaseFee)
+
+	if addr, ok := contractAddress(tx, evm.TxContext.Origin); ok {
+		receipt.ContractAddress = addr
+	}
+
+	receipt.Logs = db.GetLogs(tx.Hash(), blockNumber.Uint64(), blockHash)
+	receipt.Bloom = gethtypes.CreateBloom(gethtypes.Receipts{receipt})
+	receipt.BlockHash = blockHash
+	receipt.BlockNumber = blockNumber
+	receipt.TransactionIndex = uint(db.TxIndex())
+
+	return receipt
+}
+
+// contractAddress returns the address a contract-creation transaction
+// deployed to, and false for a transaction with a To address. This is the
+// same CREATE address derivation for every tx type (Legacy, AccessList,
+// DynamicFee): only the nonce and sender matter, not the fee fields that
+// otherwise distinguish them.
+func contractAddress(tx *gethtypes.Transaction, origin common.Address) (common.Address, bool) {
+	if tx.To() != nil {
+		return common.Address{}, false
+	}
+	return crypto.CreateAddress(origin, tx.Nonce()), true
+}
+
+// effectiveGasPrice returns the gas price a receipt should report: the
+// legacy and AccessList tx types always paid their stated GasPrice, while
+// DynamicFee transactions paid baseFee plus whatever tip was actually
+// charged.
+func effectiveGasPrice(tx *gethtypes.Transaction, baseFee *big.Int) *big.Int {
+	if tx.Type() != gethtypes.DynamicFeeTxType || baseFee == nil {
+		return tx.GasPrice()
+	}
+	return new(big.Int).Add(baseFee, tx.EffectiveGasTipValue(baseFee))
+}
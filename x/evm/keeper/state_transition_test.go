@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveGasPrice(t *testing.T) {
+	baseFee := big.NewInt(100)
+	to := common.HexToAddress("0x1000000000000000000000000000000000000000")
+
+	testCases := []struct {
+		name string
+		tx   *gethtypes.Transaction
+		want *big.Int
+	}{
+		{
+			"legacy transaction pays its stated gas price",
+			gethtypes.NewTx(&gethtypes.LegacyTx{
+				To:       &to,
+				GasPrice: big.NewInt(50),
+			}),
+			big.NewInt(50),
+		},
+		{
+			"access list transaction pays its stated gas price",
+			gethtypes.NewTx(&gethtypes.AccessListTx{
+				To:       &to,
+				GasPrice: big.NewInt(75),
+			}),
+			big.NewInt(75),
+		},
+		{
+			"dynamic fee transaction pays base fee plus its capped tip",
+			gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+				To:        &to,
+				GasFeeCap: big.NewInt(200),
+				GasTipCap: big.NewInt(10),
+			}),
+			big.NewInt(110),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, effectiveGasPrice(tc.tx, baseFee))
+		})
+	}
+}
+
+// TestContractAddress checks that MakeReceipt's contract-address derivation
+// behaves identically across tx types: the fee fields that distinguish
+// Legacy/AccessList/DynamicFee transactions must not affect the derived
+// CREATE address, and every type must leave the receipt's ContractAddress
+// unset for a plain call. A full receipt-equivalence test driving
+// ApplyTransactionWithEVM itself would need a real *statedb.StateDB, which
+// isn't part of this tree; this covers the one piece of receipt assembly
+// that actually varies its inputs by tx type.
+func TestContractAddress(t *testing.T) {
+	origin := common.HexToAddress("0x2000000000000000000000000000000000000000")
+	to := common.HexToAddress("0x1000000000000000000000000000000000000000")
+	nonce := uint64(7)
+	want := crypto.CreateAddress(origin, nonce)
+
+	creationTxs := []struct {
+		name string
+		tx   *gethtypes.Transaction
+	}{
+		{"legacy creation", gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: nonce, GasPrice: big.NewInt(50)})},
+		{"access list creation", gethtypes.NewTx(&gethtypes.AccessListTx{Nonce: nonce, GasPrice: big.NewInt(75)})},
+		{"dynamic fee creation", gethtypes.NewTx(&gethtypes.DynamicFeeTx{Nonce: nonce, GasFeeCap: big.NewInt(200), GasTipCap: big.NewInt(10)})},
+	}
+	for _, tc := range creationTxs {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, ok := contractAddress(tc.tx, origin)
+			require.True(t, ok)
+			require.Equal(t, want, addr)
+		})
+	}
+
+	callTxs := []struct {
+		name string
+		tx   *gethtypes.Transaction
+	}{
+		{"legacy call", gethtypes.NewTx(&gethtypes.LegacyTx{To: &to, Nonce: nonce, GasPrice: big.NewInt(50)})},
+		{"access list call", gethtypes.NewTx(&gethtypes.AccessListTx{To: &to, Nonce: nonce, GasPrice: big.NewInt(75)})},
+		{"dynamic fee call", gethtypes.NewTx(&gethtypes.DynamicFeeTx{To: &to, Nonce: nonce, GasFeeCap: big.NewInt(200), GasTipCap: big.NewInt(10)})},
+	}
+	for _, tc := range callTxs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ok := contractAddress(tc.tx, origin)
+			require.False(t, ok)
+		})
+	}
+}
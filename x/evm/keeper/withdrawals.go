@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// ApplyWithdrawals mints each withdrawal's amount - consensus-layer
+// withdrawals are new issuance, not a transfer out of an existing balance -
+// and credits it to the Cosmos account mapped from its Ethereum address. It
+// is called once per block, after the withdrawal list has been verified
+// against the header's WithdrawalsHash, and never fails: a withdrawal whose
+// mint or credit errors (e.g. a blocked recipient address) is skipped rather
+// than halting the block, matching how the consensus layer treats
+// withdrawals as unconditional credits.
+func (k Keeper) ApplyWithdrawals(ctx sdk.Context, withdrawals rpctypes.Withdrawals, evmDenom string) error {
+	multiplier := k.WithdrawalsGweiToDenomMultiplier()
+
+	for _, w := range withdrawals {
+		amount := new(big.Int).Mul(w.AmountBigInt(), multiplier.BigInt())
+		coins := sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(amount)))
+
+		if err := k.bankKeeper.MintCoins(ctx, evmtypes.ModuleName, coins); err != nil {
+			ctx.Logger().Error("skipping withdrawal: failed to mint", "index", w.Index, "address", w.Address, "error", err)
+			continue
+		}
+
+		recipient := sdk.AccAddress(w.Address.Bytes())
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, recipient, coins); err != nil {
+			ctx.Logger().Error("skipping withdrawal: failed to credit recipient", "index", w.Index, "address", w.Address, "error", err)
+			// The mint above already happened; undo it so a skipped
+			// withdrawal mints nothing rather than stranding coins in the
+			// module account and permanently inflating supply.
+			if burnErr := k.bankKeeper.BurnCoins(ctx, evmtypes.ModuleName, coins); burnErr != nil {
+				ctx.Logger().Error("failed to burn stranded withdrawal mint", "index", w.Index, "address", w.Address, "error", burnErr)
+			}
+			continue
+		}
+	}
+	return nil
+}
+
+// WithdrawalsGweiToDenomMultiplier returns the factor applied to a
+// withdrawal's gwei-denominated Amount to express it in the EVM denom,
+// configured per chain at NewKeeper time.
+func (k Keeper) WithdrawalsGweiToDenomMultiplier() sdkmath.Int {
+	return k.withdrawalsGweiToDenomMultiplier
+}
@@ -0,0 +1,212 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	ethparams "github.com/ethereum/go-ethereum/params"
+)
+
+// ChainConfig defines the Ethereum fork activation heights for the chain. It
+// mirrors go-ethereum's params.ChainConfig, using *sdkmath.Int instead of
+// *big.Int so the type can be stored as chain params.
+type ChainConfig struct {
+	HomesteadBlock *sdkmath.Int
+
+	DAOForkBlock   *sdkmath.Int
+	DAOForkSupport bool
+
+	EIP150Block *sdkmath.Int
+	EIP150Hash  string
+
+	EIP155Block *sdkmath.Int
+	EIP158Block *sdkmath.Int
+
+	ByzantiumBlock      *sdkmath.Int
+	ConstantinopleBlock *sdkmath.Int
+	PetersburgBlock     *sdkmath.Int
+	IstanbulBlock       *sdkmath.Int
+	MuirGlacierBlock    *sdkmath.Int
+
+	BerlinBlock       *sdkmath.Int
+	LondonBlock       *sdkmath.Int
+	ArrowGlacierBlock *sdkmath.Int
+	GrayGlacierBlock  *sdkmath.Int
+
+	MergeNetsplitBlock *sdkmath.Int
+
+	// ShanghaiBlock is the block height at which the Shanghai upgrade
+	// (EIP-4895 withdrawals, PUSH0) activates.
+	ShanghaiBlock *sdkmath.Int
+}
+
+// DefaultChainConfig returns the default ChainConfig, in which every fork this
+// module supports, up to and including Shanghai, is active from genesis
+// (height 0).
+func DefaultChainConfig() ChainConfig {
+	homesteadBlock := sdkmath.ZeroInt()
+	daoForkBlock := sdkmath.ZeroInt()
+	eip150Block := sdkmath.ZeroInt()
+	eip155Block := sdkmath.ZeroInt()
+	eip158Block := sdkmath.ZeroInt()
+	byzantiumBlock := sdkmath.ZeroInt()
+	constantinopleBlock := sdkmath.ZeroInt()
+	petersburgBlock := sdkmath.ZeroInt()
+	istanbulBlock := sdkmath.ZeroInt()
+	muirGlacierBlock := sdkmath.ZeroInt()
+	berlinBlock := sdkmath.ZeroInt()
+	londonBlock := sdkmath.ZeroInt()
+	arrowGlacierBlock := sdkmath.ZeroInt()
+	grayGlacierBlock := sdkmath.ZeroInt()
+	mergeNetsplitBlock := sdkmath.ZeroInt()
+	shanghaiBlock := sdkmath.ZeroInt()
+
+	return ChainConfig{
+		HomesteadBlock:      &homesteadBlock,
+		DAOForkBlock:        &daoForkBlock,
+		DAOForkSupport:      true,
+		EIP150Block:         &eip150Block,
+		EIP150Hash:          "",
+		EIP155Block:         &eip155Block,
+		EIP158Block:         &eip158Block,
+		ByzantiumBlock:      &byzantiumBlock,
+		ConstantinopleBlock: &constantinopleBlock,
+		PetersburgBlock:     &petersburgBlock,
+		IstanbulBlock:       &istanbulBlock,
+		MuirGlacierBlock:    &muirGlacierBlock,
+		BerlinBlock:         &berlinBlock,
+		LondonBlock:         &londonBlock,
+		ArrowGlacierBlock:   &arrowGlacierBlock,
+		GrayGlacierBlock:    &grayGlacierBlock,
+		MergeNetsplitBlock:  &mergeNetsplitBlock,
+		ShanghaiBlock:       &shanghaiBlock,
+	}
+}
+
+// EthereumConfig returns the ChainConfig translated into a go-ethereum
+// params.ChainConfig, so it can be passed directly to the go-ethereum EVM.
+func (cc ChainConfig) EthereumConfig(chainID *big.Int) *ethparams.ChainConfig {
+	return &ethparams.ChainConfig{
+		ChainID:             chainID,
+		HomesteadBlock:      getBlockValue(cc.HomesteadBlock),
+		DAOForkBlock:        getBlockValue(cc.DAOForkBlock),
+		DAOForkSupport:      cc.DAOForkSupport,
+		EIP150Block:         getBlockValue(cc.EIP150Block),
+		EIP155Block:         getBlockValue(cc.EIP155Block),
+		EIP158Block:         getBlockValue(cc.EIP158Block),
+		ByzantiumBlock:      getBlockValue(cc.ByzantiumBlock),
+		ConstantinopleBlock: getBlockValue(cc.ConstantinopleBlock),
+		PetersburgBlock:     getBlockValue(cc.PetersburgBlock),
+		IstanbulBlock:       getBlockValue(cc.IstanbulBlock),
+		MuirGlacierBlock:    getBlockValue(cc.MuirGlacierBlock),
+		BerlinBlock:         getBlockValue(cc.BerlinBlock),
+		LondonBlock:         getBlockValue(cc.LondonBlock),
+		ArrowGlacierBlock:   getBlockValue(cc.ArrowGlacierBlock),
+		GrayGlacierBlock:    getBlockValue(cc.GrayGlacierBlock),
+		MergeNetsplitBlock:  getBlockValue(cc.MergeNetsplitBlock),
+		// ShanghaiTime is deliberately left nil: go-ethereum gates Shanghai by
+		// unix timestamp, but this chain activates every fork by block height,
+		// including ShanghaiBlock. Treating a height as a timestamp (as this
+		// module used to) makes go-ethereum activate Shanghai/PUSH0 from
+		// genesis regardless of the configured height, defeating the height
+		// gate the withdrawals subsystem depends on. This module's own
+		// IsShanghai(cc, height) is the authoritative height-based gate;
+		// giving go-ethereum's EVM itself true Shanghai semantics would need a
+		// real block-height-to-timestamp mapping, which this chain doesn't
+		// track.
+	}
+}
+
+func getBlockValue(block *sdkmath.Int) *big.Int {
+	if block == nil {
+		return nil
+	}
+	return block.BigInt()
+}
+
+// Validate performs a basic sanity check on the chain config fork ordering.
+func (cc ChainConfig) Validate() error {
+	if err := validateFork("EIP150Block", cc.EIP150Block, cc.HomesteadBlock); err != nil {
+		return err
+	}
+	if err := validateFork("EIP155Block", cc.EIP155Block, cc.EIP150Block); err != nil {
+		return err
+	}
+	if err := validateFork("EIP158Block", cc.EIP158Block, cc.EIP155Block); err != nil {
+		return err
+	}
+	if err := validateFork("ByzantiumBlock", cc.ByzantiumBlock, cc.EIP158Block); err != nil {
+		return err
+	}
+	if err := validateFork("ConstantinopleBlock", cc.ConstantinopleBlock, cc.ByzantiumBlock); err != nil {
+		return err
+	}
+	if err := validateFork("PetersburgBlock", cc.PetersburgBlock, cc.ConstantinopleBlock); err != nil {
+		return err
+	}
+	if err := validateFork("IstanbulBlock", cc.IstanbulBlock, cc.PetersburgBlock); err != nil {
+		return err
+	}
+	if err := validateFork("BerlinBlock", cc.BerlinBlock, cc.IstanbulBlock); err != nil {
+		return err
+	}
+	if err := validateFork("LondonBlock", cc.LondonBlock, cc.BerlinBlock); err != nil {
+		return err
+	}
+	if err := validateFork("MergeNetsplitBlock", cc.MergeNetsplitBlock, cc.LondonBlock); err != nil {
+		return err
+	}
+	if err := validateFork("ShanghaiBlock", cc.ShanghaiBlock, cc.MergeNetsplitBlock); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateFork(name string, block, prev *sdkmath.Int) error {
+	if block == nil || prev == nil {
+		return nil
+	}
+	if block.LT(*prev) {
+		return fmt.Errorf("%s block %s is before the preceding fork block %s", name, block, prev)
+	}
+	return nil
+}
+
+// validateChainConfig checks that i is a ChainConfig and that its fork
+// ordering is internally consistent.
+func validateChainConfig(i interface{}) error {
+	cfg, ok := i.(ChainConfig)
+	if !ok {
+		return fmt.Errorf("invalid chain config type: %T", i)
+	}
+	return cfg.Validate()
+}
+
+// IsLondon returns whether height is greater than or equal to the London
+// fork block height in ethConfig.
+func IsLondon(ethConfig *ethparams.ChainConfig, height int64) bool {
+	return ethConfig.IsLondon(big.NewInt(height))
+}
+
+// IsShanghai returns whether height is greater than or equal to the Shanghai
+// fork block height configured in cc.
+func IsShanghai(cc ChainConfig, height int64) bool {
+	if cc.ShanghaiBlock == nil {
+		return false
+	}
+	return big.NewInt(height).Cmp(cc.ShanghaiBlock.BigInt()) >= 0
+}
+
+// IsMerge returns whether height is greater than or equal to the merge
+// netsplit block height configured in cc. Unlike go-ethereum, which gates the
+// merge on total terminal difficulty, this chain has no concept of mining
+// difficulty to begin with, so activation is a plain height check like every
+// other fork here.
+func IsMerge(cc ChainConfig, height int64) bool {
+	if cc.MergeNetsplitBlock == nil {
+		return false
+	}
+	return big.NewInt(height).Cmp(cc.MergeNetsplitBlock.BigInt()) >= 0
+}
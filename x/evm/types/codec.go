@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterLegacyAminoCodec registers the module's messages on cdc, for
+// backwards-compatible Amino JSON signing.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterPrecompile{}, "evm/MsgRegisterPrecompile", nil)
+	cdc.RegisterConcrete(&MsgUpdatePrecompile{}, "evm/MsgUpdatePrecompile", nil)
+}
+
+// RegisterInterfaces registers the module's messages against registry, so
+// they can be packed into Any and routed as sdk.Msg.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterPrecompile{},
+		&MsgUpdatePrecompile{},
+	)
+}
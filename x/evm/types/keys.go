@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName is the name of the EVM module, used in events and routing.
+	ModuleName = "evm"
+
+	// RouterKey is the message route for the EVM module.
+	RouterKey = ModuleName
+)
@@ -0,0 +1,28 @@
+package types
+
+import "context"
+
+// MsgServer is the service boundary the module's gov-gated precompile
+// messages are routed through once RegisterServices wires an implementation
+// into baseapp's message router - the same msgservice.RegisterMsgServiceServer
+// call every other Cosmos SDK module makes from its AppModule, which this
+// module doesn't have yet.
+type MsgServer interface {
+	RegisterPrecompile(context.Context, *MsgRegisterPrecompile) (*MsgRegisterPrecompileResponse, error)
+	UpdatePrecompile(context.Context, *MsgUpdatePrecompile) (*MsgUpdatePrecompileResponse, error)
+}
+
+// MsgRegisterPrecompileResponse is the (empty) response to
+// MsgRegisterPrecompile.
+type MsgRegisterPrecompileResponse struct{}
+
+func (*MsgRegisterPrecompileResponse) Reset()         {}
+func (*MsgRegisterPrecompileResponse) String() string { return "" }
+func (*MsgRegisterPrecompileResponse) ProtoMessage()  {}
+
+// MsgUpdatePrecompileResponse is the (empty) response to MsgUpdatePrecompile.
+type MsgUpdatePrecompileResponse struct{}
+
+func (*MsgUpdatePrecompileResponse) Reset()         {}
+func (*MsgUpdatePrecompileResponse) String() string { return "" }
+func (*MsgUpdatePrecompileResponse) ProtoMessage()  {}
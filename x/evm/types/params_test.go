@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -333,3 +335,23 @@ func TestIsLondon(t *testing.T) {
 		require.Equal(t, IsLondon(ethConfig, tc.height), tc.result)
 	}
 }
+
+func TestIsMerge(t *testing.T) {
+	cfg := DefaultChainConfig()
+	mergeBlock := sdkmath.NewInt(100)
+	cfg.MergeNetsplitBlock = &mergeBlock
+
+	testCases := []struct {
+		name   string
+		height int64
+		result bool
+	}{
+		{"before merge block", 5, false},
+		{"merge block", 100, true},
+		{"after merge block", 101, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.result, IsMerge(cfg, tc.height), tc.name)
+	}
+}
@@ -0,0 +1,266 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GasScheduleKind selects how a registered precompile's gas cost is computed.
+type GasScheduleKind int32
+
+const (
+	// GasScheduleLinearPerWord charges BaseCost plus PerWordCost for every
+	// 32-byte word of input, mirroring precompiles like IDENTITY/MODEXP.
+	GasScheduleLinearPerWord GasScheduleKind = iota
+	// GasScheduleFlatPerCall charges a fixed BaseCost regardless of input
+	// size, mirroring precompiles like ECRECOVER.
+	GasScheduleFlatPerCall
+	// GasScheduleDynamic delegates pricing to the Go callback registered for
+	// the precompile's ImplementationID with the keeper's in-process
+	// RegisterGasCallback; BaseCost/PerWordCost are unused.
+	GasScheduleDynamic
+)
+
+// GasSchedule is the on-chain, governance-controlled pricing for a single
+// registered precompile.
+type GasSchedule struct {
+	Kind        GasScheduleKind
+	BaseCost    uint64
+	PerWordCost uint64
+}
+
+// Cost returns the gas cost of calling the precompile with the given input.
+// For GasScheduleDynamic it delegates to callback, which the caller must
+// resolve from the implementation's registered pricing function.
+func (g GasSchedule) Cost(input []byte, callback func([]byte) (uint64, error)) (uint64, error) {
+	switch g.Kind {
+	case GasScheduleFlatPerCall:
+		return g.BaseCost, nil
+	case GasScheduleLinearPerWord:
+		words := uint64(len(input)+31) / 32
+		return g.BaseCost + words*g.PerWordCost, nil
+	case GasScheduleDynamic:
+		if callback == nil {
+			return 0, fmt.Errorf("dynamic gas schedule has no registered pricing callback")
+		}
+		return callback(input)
+	default:
+		return 0, fmt.Errorf("unknown gas schedule kind %d", g.Kind)
+	}
+}
+
+// PrecompileRegistryEntry is the governance-controlled record for a single
+// registered precompile: which address it lives at, which implementation
+// backs it, how it's priced, and from which height it is active.
+type PrecompileRegistryEntry struct {
+	Address          common.Address
+	ImplementationID string
+	GasSchedule      GasSchedule
+	ActivationHeight int64
+	// Params is an opaque, implementation-defined configuration blob (e.g.
+	// encoded as the implementation's own protobuf params type).
+	Params []byte
+}
+
+// IsActiveAt returns whether the entry is active at the given block height.
+func (e PrecompileRegistryEntry) IsActiveAt(height int64) bool {
+	return e.ActivationHeight >= 0 && height >= e.ActivationHeight
+}
+
+// Validate performs basic sanity checks on a registry entry, independent of
+// any particular chain state.
+func (e PrecompileRegistryEntry) Validate() error {
+	if e.Address == (common.Address{}) {
+		return fmt.Errorf("precompile address cannot be the zero address")
+	}
+	if e.ImplementationID == "" {
+		return fmt.Errorf("precompile implementation id cannot be empty")
+	}
+	if e.ActivationHeight < 0 {
+		return fmt.Errorf("activation height cannot be negative: %d", e.ActivationHeight)
+	}
+	switch e.GasSchedule.Kind {
+	case GasScheduleLinearPerWord, GasScheduleFlatPerCall, GasScheduleDynamic:
+	default:
+		return fmt.Errorf("unknown gas schedule kind %d", e.GasSchedule.Kind)
+	}
+	return nil
+}
+
+// The methods below make PrecompileRegistryEntry satisfy
+// codec.ProtoMarshaler so the keeper can store it through the module's
+// BinaryCodec like every other piece of keeper state, instead of bypassing
+// it with encoding/json. There is no .proto definition for this type yet
+// (this module has no protoc-generated types at all), so Marshal/Unmarshal
+// below hand-roll a simple length-prefixed encoding rather than real
+// protobuf wire format; once the module gains a proper proto definition,
+// codegen replaces this with the real thing and a store migration re-keys
+// existing entries.
+
+func (e *PrecompileRegistryEntry) Reset()         { *e = PrecompileRegistryEntry{} }
+func (e *PrecompileRegistryEntry) String() string { return fmt.Sprintf("%+v", *e) }
+func (*PrecompileRegistryEntry) ProtoMessage()    {}
+
+func (e *PrecompileRegistryEntry) Marshal() ([]byte, error) {
+	var buf []byte
+
+	buf = appendLengthPrefixed(buf, e.Address.Bytes())
+	buf = appendLengthPrefixed(buf, []byte(e.ImplementationID))
+
+	schedule, err := e.GasSchedule.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	buf = appendLengthPrefixed(buf, schedule)
+
+	buf = appendUint64(buf, uint64(e.ActivationHeight))
+	buf = appendLengthPrefixed(buf, e.Params)
+
+	return buf, nil
+}
+
+func (e *PrecompileRegistryEntry) MarshalTo(data []byte) (int, error) {
+	bz, err := e.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return copy(data, bz), nil
+}
+
+func (e *PrecompileRegistryEntry) MarshalToSizedBuffer(data []byte) (int, error) {
+	bz, err := e.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return copy(data[len(data)-len(bz):], bz), nil
+}
+
+func (e *PrecompileRegistryEntry) Size() int {
+	bz, _ := e.Marshal()
+	return len(bz)
+}
+
+func (e *PrecompileRegistryEntry) Unmarshal(data []byte) error {
+	addr, rest, err := readLengthPrefixed(data)
+	if err != nil {
+		return err
+	}
+	e.Address = common.BytesToAddress(addr)
+
+	implID, rest, err := readLengthPrefixed(rest)
+	if err != nil {
+		return err
+	}
+	e.ImplementationID = string(implID)
+
+	scheduleBz, rest, err := readLengthPrefixed(rest)
+	if err != nil {
+		return err
+	}
+	if err := e.GasSchedule.Unmarshal(scheduleBz); err != nil {
+		return err
+	}
+
+	height, rest, err := readUint64(rest)
+	if err != nil {
+		return err
+	}
+	e.ActivationHeight = int64(height)
+
+	params, _, err := readLengthPrefixed(rest)
+	if err != nil {
+		return err
+	}
+	if len(params) == 0 {
+		params = nil
+	}
+	e.Params = params
+
+	return nil
+}
+
+func (g *GasSchedule) Reset()         { *g = GasSchedule{} }
+func (g *GasSchedule) String() string { return fmt.Sprintf("%+v", *g) }
+func (*GasSchedule) ProtoMessage()    {}
+
+func (g *GasSchedule) Marshal() ([]byte, error) {
+	buf := make([]byte, 0, 24)
+	buf = appendUint64(buf, uint64(g.Kind))
+	buf = appendUint64(buf, g.BaseCost)
+	buf = appendUint64(buf, g.PerWordCost)
+	return buf, nil
+}
+
+func (g *GasSchedule) MarshalTo(data []byte) (int, error) {
+	bz, err := g.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return copy(data, bz), nil
+}
+
+func (g *GasSchedule) MarshalToSizedBuffer(data []byte) (int, error) {
+	bz, err := g.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return copy(data[len(data)-len(bz):], bz), nil
+}
+
+func (g *GasSchedule) Size() int {
+	bz, _ := g.Marshal()
+	return len(bz)
+}
+
+func (g *GasSchedule) Unmarshal(data []byte) error {
+	kind, rest, err := readUint64(data)
+	if err != nil {
+		return err
+	}
+	g.Kind = GasScheduleKind(kind)
+
+	baseCost, rest, err := readUint64(rest)
+	if err != nil {
+		return err
+	}
+	g.BaseCost = baseCost
+
+	perWordCost, _, err := readUint64(rest)
+	if err != nil {
+		return err
+	}
+	g.PerWordCost = perWordCost
+
+	return nil
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var tmp [8]byte
+	binary.BigEndian.PutUint64(tmp[:], v)
+	return append(buf, tmp[:]...)
+}
+
+func readUint64(data []byte) (uint64, []byte, error) {
+	if len(data) < 8 {
+		return 0, nil, fmt.Errorf("precompile registry entry: truncated uint64")
+	}
+	return binary.BigEndian.Uint64(data[:8]), data[8:], nil
+}
+
+func appendLengthPrefixed(buf []byte, v []byte) []byte {
+	buf = appendUint64(buf, uint64(len(v)))
+	return append(buf, v...)
+}
+
+func readLengthPrefixed(data []byte) ([]byte, []byte, error) {
+	length, rest, err := readUint64(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint64(len(rest)) < length {
+		return nil, nil, fmt.Errorf("precompile registry entry: truncated field of length %d", length)
+	}
+	return rest[:length], rest[length:], nil
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasScheduleCost(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule GasSchedule
+		input    []byte
+		callback func([]byte) (uint64, error)
+		want     uint64
+		expError bool
+	}{
+		{
+			name:     "flat per call ignores input size",
+			schedule: GasSchedule{Kind: GasScheduleFlatPerCall, BaseCost: 3000},
+			input:    make([]byte, 100),
+			want:     3000,
+		},
+		{
+			name:     "linear per word charges for each 32-byte word, rounding up",
+			schedule: GasSchedule{Kind: GasScheduleLinearPerWord, BaseCost: 15, PerWordCost: 3},
+			input:    make([]byte, 33),
+			want:     15 + 2*3,
+		},
+		{
+			name:     "linear per word with empty input only charges the base cost",
+			schedule: GasSchedule{Kind: GasScheduleLinearPerWord, BaseCost: 15, PerWordCost: 3},
+			input:    nil,
+			want:     15,
+		},
+		{
+			name:     "dynamic delegates to the callback",
+			schedule: GasSchedule{Kind: GasScheduleDynamic},
+			input:    []byte{1, 2, 3},
+			callback: func(input []byte) (uint64, error) { return uint64(len(input)) * 7, nil },
+			want:     21,
+		},
+		{
+			name:     "dynamic with no registered callback errors",
+			schedule: GasSchedule{Kind: GasScheduleDynamic},
+			input:    []byte{1},
+			expError: true,
+		},
+		{
+			name:     "unknown kind errors",
+			schedule: GasSchedule{Kind: GasScheduleKind(99)},
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.schedule.Cost(tc.input, tc.callback)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// TestPrecompileRegistryEntryMarshalRoundTrip guards the hand-rolled binary
+// codec PrecompileRegistryEntry uses in place of generated protobuf code: a
+// slip in the length-prefixed encoding would silently corrupt stored
+// entries rather than fail loudly.
+func TestPrecompileRegistryEntryMarshalRoundTrip(t *testing.T) {
+	entry := PrecompileRegistryEntry{
+		Address:          common.HexToAddress("0x1234"),
+		ImplementationID: "bls12381-pairing",
+		GasSchedule: GasSchedule{
+			Kind:        GasScheduleLinearPerWord,
+			BaseCost:    15,
+			PerWordCost: 3,
+		},
+		ActivationHeight: 100,
+		Params:           []byte{0xde, 0xad, 0xbe, 0xef},
+	}
+
+	bz, err := entry.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, len(bz), entry.Size())
+
+	var decoded PrecompileRegistryEntry
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, entry, decoded)
+}
+
+func TestPrecompileRegistryEntryMarshalRoundTripEmptyParams(t *testing.T) {
+	entry := PrecompileRegistryEntry{
+		Address:          common.HexToAddress("0xabcd"),
+		ImplementationID: "identity",
+		GasSchedule:      GasSchedule{Kind: GasScheduleFlatPerCall, BaseCost: 15},
+		ActivationHeight: 0,
+	}
+
+	bz, err := entry.Marshal()
+	require.NoError(t, err)
+
+	var decoded PrecompileRegistryEntry
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, entry, decoded)
+}
+
+func TestPrecompileRegistryEntryIsActiveAt(t *testing.T) {
+	testCases := []struct {
+		name   string
+		entry  PrecompileRegistryEntry
+		height int64
+		want   bool
+	}{
+		{"before activation height", PrecompileRegistryEntry{ActivationHeight: 100}, 99, false},
+		{"at activation height", PrecompileRegistryEntry{ActivationHeight: 100}, 100, true},
+		{"after activation height", PrecompileRegistryEntry{ActivationHeight: 100}, 101, true},
+		{"negative activation height is never active", PrecompileRegistryEntry{ActivationHeight: -1}, 1000, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, tc.entry.IsActiveAt(tc.height))
+		})
+	}
+}
+
+func TestPrecompileRegistryEntryValidate(t *testing.T) {
+	valid := PrecompileRegistryEntry{
+		Address:          common.HexToAddress("0x1234"),
+		ImplementationID: "identity",
+		ActivationHeight: 0,
+	}
+	require.NoError(t, valid.Validate())
+
+	testCases := []struct {
+		name  string
+		entry PrecompileRegistryEntry
+	}{
+		{"zero address", PrecompileRegistryEntry{ImplementationID: "identity"}},
+		{"empty implementation id", PrecompileRegistryEntry{Address: common.HexToAddress("0x1234")}},
+		{
+			"negative activation height",
+			PrecompileRegistryEntry{
+				Address:          common.HexToAddress("0x1234"),
+				ImplementationID: "identity",
+				ActivationHeight: -1,
+			},
+		},
+		{
+			"unknown gas schedule kind",
+			PrecompileRegistryEntry{
+				Address:          common.HexToAddress("0x1234"),
+				ImplementationID: "identity",
+				GasSchedule:      GasSchedule{Kind: GasScheduleKind(99)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, tc.entry.Validate())
+		})
+	}
+}